@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/spf13/viper"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// Client constructs a Jira API client from the persisted CLI configuration.
+// Only Debug is taken from the given config; its other fields are ignored.
+func Client(c jira.Config) *jira.Client {
+	cfg := jira.Config{
+		Server:   viper.GetString("server"),
+		Login:    viper.GetString("login"),
+		APIToken: viper.GetString("api_token"),
+		Insecure: viper.GetBool("insecure"),
+		Debug:    c.Debug,
+	}
+
+	return jira.NewClient(cfg)
+}
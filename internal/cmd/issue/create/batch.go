@@ -0,0 +1,262 @@
+package create
+
+import (
+	"encoding/csv"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+// batchRow is a single issue to create when using `--from-file`. The local
+// id, when set, lets other rows reference this row in their own `links`
+// before the real issue key exists.
+type batchRow struct {
+	ID         string   `yaml:"id"`
+	Type       string   `yaml:"type"`
+	Summary    string   `yaml:"summary"`
+	Body       string   `yaml:"body"`
+	Priority   string   `yaml:"priority"`
+	Labels     []string `yaml:"labels"`
+	Components []string `yaml:"components"`
+	Assignee   string   `yaml:"assignee"`
+	Parent     string   `yaml:"parent"`
+	Links      []string `yaml:"links"`
+}
+
+type batchManifest struct {
+	Issues []batchRow `yaml:"issues"`
+}
+
+// batchResult records the outcome of creating a single row. warnings holds
+// non-fatal problems (eg. an unresolvable assignee or link) that shouldn't
+// stop the rest of the batch from running.
+type batchResult struct {
+	row      batchRow
+	key      string
+	err      error
+	warnings []string
+}
+
+// loadBatchManifest reads a YAML or CSV manifest of issues to create. The
+// format is inferred from the file extension.
+func loadBatchManifest(path string) ([]batchRow, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yml", ".yaml":
+		var mf batchManifest
+		if err := yaml.Unmarshal(b, &mf); err != nil {
+			return nil, fmt.Errorf("invalid manifest %q: %s", path, err)
+		}
+		return mf.Issues, nil
+
+	case ".csv":
+		return parseBatchCSV(b)
+
+	default:
+		return nil, fmt.Errorf("unsupported manifest format %q, expected .yml, .yaml or .csv", path)
+	}
+}
+
+func parseBatchCSV(b []byte) ([]batchRow, error) {
+	r := csv.NewReader(strings.NewReader(string(b)))
+
+	records, err := r.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(records) < 2 {
+		return nil, nil
+	}
+
+	header := records[0]
+	col := make(map[string]int, len(header))
+	for i, h := range header {
+		col[strings.TrimSpace(h)] = i
+	}
+
+	get := func(rec []string, name string) string {
+		i, ok := col[name]
+		if !ok || i >= len(rec) {
+			return ""
+		}
+		return strings.TrimSpace(rec[i])
+	}
+	getList := func(rec []string, name string) []string {
+		v := get(rec, name)
+		if v == "" {
+			return nil
+		}
+		return strings.Split(v, "|")
+	}
+
+	rows := make([]batchRow, 0, len(records)-1)
+	for _, rec := range records[1:] {
+		rows = append(rows, batchRow{
+			ID:         get(rec, "id"),
+			Type:       get(rec, "type"),
+			Summary:    get(rec, "summary"),
+			Body:       get(rec, "body"),
+			Priority:   get(rec, "priority"),
+			Labels:     getList(rec, "labels"),
+			Components: getList(rec, "components"),
+			Assignee:   get(rec, "assignee"),
+			Parent:     get(rec, "parent"),
+			Links:      getList(rec, "links"),
+		})
+	}
+
+	return rows, nil
+}
+
+// runBatch creates every row in the manifest, continuing past per-row
+// failures so the caller gets a partial-success report, then wires up
+// intra-batch links by local id once every row has been attempted.
+func runBatch(cc *createCmd, project string, rows []batchRow, parallel int) []batchResult {
+	results := make([]batchResult, len(rows))
+
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	sem := make(chan struct{}, parallel)
+	var wg sync.WaitGroup
+
+	for i, row := range rows {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(i int, row batchRow) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			results[i] = createBatchRow(cc, project, row)
+		}(i, row)
+	}
+	wg.Wait()
+
+	keyByID := make(map[string]string)
+	for _, res := range results {
+		if res.err == nil && res.row.ID != "" {
+			keyByID[res.row.ID] = res.key
+		}
+	}
+
+	for i := range results {
+		if results[i].err != nil || len(results[i].row.Links) == 0 {
+			continue
+		}
+		for _, l := range results[i].row.Links {
+			linkType, target, ok := resolveLink(l, keyByID)
+			if !ok {
+				results[i].warnings = append(results[i].warnings, fmt.Sprintf("invalid link %q", l))
+				continue
+			}
+			if err := cc.client.LinkIssue(results[i].key, target, linkType); err != nil {
+				results[i].warnings = append(
+					results[i].warnings,
+					fmt.Sprintf("unable to link to %s: %s", target, err.Error()),
+				)
+			}
+		}
+	}
+
+	return results
+}
+
+// resolveLink parses a `type=target` link entry from a manifest row and
+// resolves target to another row's created key when it names a local id.
+func resolveLink(raw string, keyByID map[string]string) (linkType, target string, ok bool) {
+	parts := strings.SplitN(raw, "=", 2)
+	if len(parts) != 2 {
+		return "", "", false
+	}
+
+	linkType, target = strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+	if linkType == "" || target == "" {
+		return "", "", false
+	}
+
+	if k, found := keyByID[target]; found {
+		target = k
+	}
+
+	return linkType, target, true
+}
+
+func createBatchRow(cc *createCmd, project string, row batchRow) batchResult {
+	cr := jira.CreateRequest{
+		Project:    project,
+		IssueType:  row.Type,
+		Summary:    row.Summary,
+		Body:       row.Body,
+		Priority:   row.Priority,
+		Labels:     row.Labels,
+		Components: row.Components,
+		Parent:     row.Parent,
+	}
+
+	resp, err := cc.client.Create(&cr)
+	if err != nil {
+		return batchResult{row: row, err: err}
+	}
+
+	res := batchResult{row: row, key: resp.Key}
+
+	if row.Assignee != "" {
+		user, err := cc.client.UserSearch(&jira.UserSearchOptions{Query: row.Assignee})
+		if err != nil || len(user) == 0 {
+			res.warnings = append(res.warnings, fmt.Sprintf("unable to find assignee %q", row.Assignee))
+		} else if err := cc.client.AssignIssue(resp.Key, user[0].AccountID); err != nil {
+			res.warnings = append(res.warnings, fmt.Sprintf("unable to assign: %s", err.Error()))
+		}
+	}
+
+	return res
+}
+
+// printBatchSummary prints a table of created keys and any per-row errors.
+func printBatchSummary(results []batchResult) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "SUMMARY\tKEY\tSTATUS")
+
+	for _, res := range results {
+		if res.err != nil {
+			fmt.Fprintf(w, "%s\t-\tFAILED: %s\n", res.row.Summary, res.err.Error())
+			continue
+		}
+
+		status := "OK"
+		if len(res.warnings) > 0 {
+			status = fmt.Sprintf("OK (%s)", strings.Join(res.warnings, "; "))
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", res.row.Summary, res.key, status)
+	}
+}
+
+// printBatchDryRun previews the rows that would be created without talking
+// to the Jira API.
+func printBatchDryRun(project string, rows []batchRow) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	defer w.Flush()
+
+	fmt.Fprintln(w, "ID\tTYPE\tSUMMARY\tLINKS")
+
+	for _, row := range rows {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", row.ID, row.Type, row.Summary, strings.Join(row.Links, ", "))
+	}
+
+	fmt.Printf("\n%d issue(s) would be created in project %s\n", len(rows), project)
+}
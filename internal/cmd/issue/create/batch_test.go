@@ -0,0 +1,91 @@
+package create
+
+import (
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestParseBatchCSV(t *testing.T) {
+	csv := "id,type,summary,assignee,labels,links\n" +
+		"1,Bug,First bug,jdoe,foo|bar,blocks=2\n" +
+		"2,Task,Second task,,,\n"
+
+	rows, err := parseBatchCSV([]byte(csv))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rows) != 2 {
+		t.Fatalf("expected 2 rows, got %d", len(rows))
+	}
+
+	want := batchRow{
+		ID:       "1",
+		Type:     "Bug",
+		Summary:  "First bug",
+		Assignee: "jdoe",
+		Labels:   []string{"foo", "bar"},
+		Links:    []string{"blocks=2"},
+	}
+	if !reflect.DeepEqual(rows[0], want) {
+		t.Fatalf("got %+v, want %+v", rows[0], want)
+	}
+
+	if rows[1].Labels != nil {
+		t.Fatalf("expected no labels for row with empty column, got %v", rows[1].Labels)
+	}
+}
+
+func TestParseBatchCSVHeaderOnly(t *testing.T) {
+	rows, err := parseBatchCSV([]byte("id,type,summary\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rows) != 0 {
+		t.Fatalf("expected no rows, got %d", len(rows))
+	}
+}
+
+func TestLoadBatchManifestUnsupportedExtension(t *testing.T) {
+	dir := t.TempDir()
+	path := dir + "/manifest.txt"
+	if err := os.WriteFile(path, []byte("issues: []"), 0o600); err != nil {
+		t.Fatalf("setup: %s", err)
+	}
+
+	if _, err := loadBatchManifest(path); err == nil {
+		t.Fatalf("expected an error for an unsupported manifest extension")
+	}
+}
+
+func TestResolveLink(t *testing.T) {
+	keyByID := map[string]string{"2": "PRJ-2"}
+
+	cases := []struct {
+		name       string
+		raw        string
+		wantType   string
+		wantTarget string
+		wantOK     bool
+	}{
+		{name: "resolves local id", raw: "blocks=2", wantType: "blocks", wantTarget: "PRJ-2", wantOK: true},
+		{name: "falls back to literal key", raw: "blocks=PRJ-9", wantType: "blocks", wantTarget: "PRJ-9", wantOK: true},
+		{name: "missing equals", raw: "blocksPRJ-9", wantOK: false},
+		{name: "missing target", raw: "blocks=", wantOK: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			linkType, target, ok := resolveLink(tc.raw, keyByID)
+			if ok != tc.wantOK {
+				t.Fatalf("got ok=%v, want %v", ok, tc.wantOK)
+			}
+			if !tc.wantOK {
+				return
+			}
+			if linkType != tc.wantType || target != tc.wantTarget {
+				t.Fatalf("got (%q, %q), want (%q, %q)", linkType, target, tc.wantType, tc.wantTarget)
+			}
+		})
+	}
+}
@@ -41,6 +41,17 @@ func NewCmdCreate() *cobra.Command {
 // SetFlags sets flags supported by create command.
 func SetFlags(cmd *cobra.Command) {
 	cmdcommon.SetCreateFlags(cmd, "Issue")
+
+	cmd.Flags().StringArray("link", []string{}, "Link this issue to another issue\n"+
+		"The type is matched case-insensitively against the link type names of the Jira instance (eg. Blocks, Relates), not their inward/outward descriptions\n"+
+		`Example: jira issue create --link "blocks=PRJ-1" --link "relates=PRJ-2"`)
+
+	cmd.Flags().String("from-file", "", "Create issues in bulk from a YAML or CSV manifest")
+	cmd.Flags().Bool("dry-run", false, "Preview the issues a --from-file manifest would create without creating them")
+	cmd.Flags().Int("parallel", 1, "Number of issues to create concurrently when using --from-file")
+
+	cmd.Flags().StringArray("var", []string{}, "Set a template variable, use multiple times\n"+
+		`Example: jira issue create --template issue.tmpl --var env=prod --var owner=jdoe`)
 }
 
 func create(cmd *cobra.Command, _ []string) {
@@ -50,8 +61,23 @@ func create(cmd *cobra.Command, _ []string) {
 	params := parseFlags(cmd.Flags())
 	client := api.Client(jira.Config{Debug: params.debug})
 	cc := createCmd{
-		client: client,
-		params: params,
+		client:  client,
+		project: project,
+		params:  params,
+	}
+
+	if params.fromFile != "" {
+		rows, err := loadBatchManifest(params.fromFile)
+		cmdutil.ExitIfError(err)
+
+		if params.dryRun {
+			printBatchDryRun(project, rows)
+			return
+		}
+
+		printBatchSummary(runBatch(&cc, project, rows, params.parallel))
+
+		return
 	}
 
 	if cc.isNonInteractive() {
@@ -66,6 +92,10 @@ func create(cmd *cobra.Command, _ []string) {
 
 	cmdutil.ExitIfError(cc.setIssueTypes())
 
+	links, err := parseIssueLinks(params.links)
+	cmdutil.ExitIfError(err)
+	cmdutil.ExitIfError(cc.validateLinkTypes(links))
+
 	qs := cc.getQuestions()
 	if len(qs) > 0 {
 		ans := struct{ IssueType, Summary, Body string }{}
@@ -117,6 +147,14 @@ func create(cmd *cobra.Command, _ []string) {
 						params.components = strings.Split(ans.Components, ",")
 					}
 				}
+
+				for _, m := range ans.Metadata {
+					if m == cmdcommon.MetadataLinks {
+						newLinks, err := promptForLinks(cc.linkTypeNames())
+						cmdutil.ExitIfError(err)
+						links = append(links, newLinks...)
+					}
+				}
 			}
 		}
 	}
@@ -155,6 +193,12 @@ func create(cmd *cobra.Command, _ []string) {
 		}
 	}
 
+	for _, l := range links {
+		if err := client.LinkIssue(key, l.Key, l.Type); err != nil {
+			cmdutil.Errorf("\033[0;31m✗\033[0m Unable to link issue %s: %s", l.Key, err.Error())
+		}
+	}
+
 	if web, _ := cmd.Flags().GetBool("web"); web {
 		err := cmdutil.Navigate(server, key)
 		cmdutil.ExitIfError(err)
@@ -163,10 +207,71 @@ func create(cmd *cobra.Command, _ []string) {
 
 type createCmd struct {
 	client     *jira.Client
+	project    string
 	issueTypes []*jira.IssueType
+	linkTypes  []string
 	params     *createParams
 }
 
+// issueLink is a single `--link "type=KEY"` entry or its interactive
+// equivalent, resolved into a type name and the issue key to link against.
+type issueLink struct {
+	Type string
+	Key  string
+}
+
+func parseIssueLinks(raw []string) ([]issueLink, error) {
+	links := make([]issueLink, 0, len(raw))
+
+	for _, r := range raw {
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+			return nil, fmt.Errorf(`invalid --link value %q, expected "type=KEY"`, r)
+		}
+		links = append(links, issueLink{Type: strings.TrimSpace(parts[0]), Key: strings.TrimSpace(parts[1])})
+	}
+
+	return links, nil
+}
+
+func promptForLinks(types []string) ([]issueLink, error) {
+	var links []issueLink
+
+	for {
+		ans := struct{ Type, Key string }{}
+		qs := []*survey.Question{
+			{
+				Name:     "type",
+				Prompt:   &survey.Select{Message: "Link type:", Options: types},
+				Validate: survey.Required,
+			},
+			{
+				Name:     "key",
+				Prompt:   &survey.Input{Message: "Issue key:"},
+				Validate: survey.Required,
+			},
+		}
+		if err := survey.Ask(qs, &ans); err != nil {
+			return nil, err
+		}
+		links = append(links, issueLink{Type: ans.Type, Key: ans.Key})
+
+		more := struct{ Confirm bool }{}
+		err := survey.Ask([]*survey.Question{{
+			Name:   "confirm",
+			Prompt: &survey.Confirm{Message: "Add another link?", Default: false},
+		}}, &more)
+		if err != nil {
+			return nil, err
+		}
+		if !more.Confirm {
+			break
+		}
+	}
+
+	return links, nil
+}
+
 func (cc *createCmd) setIssueTypes() error {
 	issueTypes := make([]*jira.IssueType, 0)
 	availableTypes, ok := viper.Get("issue.types").([]interface{})
@@ -194,6 +299,57 @@ func (cc *createCmd) setIssueTypes() error {
 	return nil
 }
 
+func (cc *createCmd) setIssueLinkTypes() error {
+	linkTypes, err := cc.client.GetIssueLinkTypes()
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(linkTypes))
+	for _, lt := range linkTypes {
+		names = append(names, lt.Name)
+	}
+	cc.linkTypes = names
+
+	return nil
+}
+
+// linkTypeNames lazily fetches and caches the available link type names for
+// use in the interactive link prompt.
+func (cc *createCmd) linkTypeNames() []string {
+	if cc.linkTypes == nil {
+		cmdutil.ExitIfError(cc.setIssueLinkTypes())
+	}
+
+	return cc.linkTypes
+}
+
+func (cc *createCmd) validateLinkTypes(links []issueLink) error {
+	if len(links) == 0 {
+		return nil
+	}
+	if cc.linkTypes == nil {
+		if err := cc.setIssueLinkTypes(); err != nil {
+			return err
+		}
+	}
+
+	for _, l := range links {
+		var found bool
+		for _, t := range cc.linkTypes {
+			if strings.EqualFold(t, l.Type) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return fmt.Errorf("unknown link type %q", l.Type)
+		}
+	}
+
+	return nil
+}
+
 func (cc *createCmd) getQuestions() []*survey.Question {
 	var qs []*survey.Question
 
@@ -222,7 +378,35 @@ func (cc *createCmd) getQuestions() []*survey.Question {
 
 	var defaultBody string
 
-	if cc.params.template != "" || cmdutil.StdinHasData() {
+	if cc.params.template != "" {
+		raw, err := cmdutil.ReadFile(cc.params.template)
+		if err != nil {
+			cmdutil.Errorf(fmt.Sprintf("\u001B[0;31m✗\u001B[0m Error: %s", err))
+		}
+
+		fm, body, err := splitTemplateFrontMatter(raw)
+		if err != nil {
+			cmdutil.Errorf(fmt.Sprintf("\u001B[0;31m✗\u001B[0m Error parsing template front-matter: %s", err))
+		}
+		cc.applyTemplateFrontMatter(fm)
+
+		// Drop the type and summary prompts queued above once the
+		// front-matter has filled those fields in.
+		pending := qs[:0]
+		for _, q := range qs {
+			if (q.Name == "issueType" && cc.params.issueType != "") || (q.Name == "summary" && cc.params.summary != "") {
+				continue
+			}
+			pending = append(pending, q)
+		}
+		qs = pending
+
+		rendered, err := cc.renderTemplateBody(body, cc.params.vars)
+		if err != nil {
+			cmdutil.Errorf(fmt.Sprintf("\u001B[0;31m✗\u001B[0m Error rendering template: %s", err))
+		}
+		defaultBody = rendered
+	} else if cmdutil.StdinHasData() {
 		b, err := cmdutil.ReadFile(cc.params.template)
 		if err != nil {
 			cmdutil.Errorf(fmt.Sprintf("\u001B[0;31m✗\u001B[0m Error: %s", err))
@@ -271,7 +455,12 @@ type createParams struct {
 	assignee   string
 	labels     []string
 	components []string
+	links      []string
+	vars       map[string]string
 	template   string
+	fromFile   string
+	dryRun     bool
+	parallel   int
 	noInput    bool
 	debug      bool
 }
@@ -298,9 +487,26 @@ func parseFlags(flags query.FlagParser) *createParams {
 	components, err := flags.GetStringArray("component")
 	cmdutil.ExitIfError(err)
 
+	links, err := flags.GetStringArray("link")
+	cmdutil.ExitIfError(err)
+
+	rawVars, err := flags.GetStringArray("var")
+	cmdutil.ExitIfError(err)
+	vars, err := parseVars(rawVars)
+	cmdutil.ExitIfError(err)
+
 	template, err := flags.GetString("template")
 	cmdutil.ExitIfError(err)
 
+	fromFile, err := flags.GetString("from-file")
+	cmdutil.ExitIfError(err)
+
+	dryRun, err := flags.GetBool("dry-run")
+	cmdutil.ExitIfError(err)
+
+	parallel, err := flags.GetInt("parallel")
+	cmdutil.ExitIfError(err)
+
 	noInput, err := flags.GetBool("no-input")
 	cmdutil.ExitIfError(err)
 
@@ -315,7 +521,12 @@ func parseFlags(flags query.FlagParser) *createParams {
 		assignee:   assignee,
 		labels:     labels,
 		components: components,
+		links:      links,
+		vars:       vars,
 		template:   template,
+		fromFile:   fromFile,
+		dryRun:     dryRun,
+		parallel:   parallel,
 		noInput:    noInput,
 		debug:      debug,
 	}
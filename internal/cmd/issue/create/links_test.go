@@ -0,0 +1,62 @@
+package create
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseIssueLinks(t *testing.T) {
+	cases := []struct {
+		name    string
+		raw     []string
+		want    []issueLink
+		wantErr bool
+	}{
+		{
+			name: "valid links",
+			raw:  []string{"blocks=PRJ-1", " relates to = PRJ-2 "},
+			want: []issueLink{
+				{Type: "blocks", Key: "PRJ-1"},
+				{Type: "relates to", Key: "PRJ-2"},
+			},
+		},
+		{
+			name: "empty input",
+			raw:  nil,
+			want: []issueLink{},
+		},
+		{
+			name:    "missing equals",
+			raw:     []string{"blocksPRJ-1"},
+			wantErr: true,
+		},
+		{
+			name:    "missing key",
+			raw:     []string{"blocks="},
+			wantErr: true,
+		},
+		{
+			name:    "missing type",
+			raw:     []string{"=PRJ-1"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseIssueLinks(tc.raw)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got nil")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
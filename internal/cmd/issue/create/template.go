@@ -0,0 +1,141 @@
+package create
+
+import (
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// templateFrontMatter is the optional YAML block at the top of a --template
+// file, delimited by `---` lines, applied to createParams before prompting.
+type templateFrontMatter struct {
+	Type       string   `yaml:"type"`
+	Summary    string   `yaml:"summary"`
+	Priority   string   `yaml:"priority"`
+	Labels     []string `yaml:"labels"`
+	Components []string `yaml:"components"`
+	Assignee   string   `yaml:"assignee"`
+}
+
+// templateData is the variable set available inside a --template body.
+type templateData struct {
+	User    string
+	Project string
+	Date    string
+	Env     map[string]string
+
+	vars map[string]string
+}
+
+// Arg returns the value of a `--var name=value` passed on the command line.
+func (td templateData) Arg(name string) string {
+	return td.vars[name]
+}
+
+func parseVars(raw []string) (map[string]string, error) {
+	vars := make(map[string]string, len(raw))
+
+	for _, r := range raw {
+		parts := strings.SplitN(r, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf(`invalid --var value %q, expected "name=value"`, r)
+		}
+		vars[parts[0]] = parts[1]
+	}
+
+	return vars, nil
+}
+
+// splitTemplateFrontMatter separates a leading `---`-delimited YAML block
+// from the rest of the template body. A file without front-matter is
+// returned unchanged so plain templates keep working.
+func splitTemplateFrontMatter(b []byte) (templateFrontMatter, []byte, error) {
+	const delim = "---"
+
+	s := strings.ReplaceAll(string(b), "\r\n", "\n")
+	if !strings.HasPrefix(s, delim+"\n") {
+		return templateFrontMatter{}, b, nil
+	}
+
+	rest := strings.TrimPrefix(s, delim+"\n")
+
+	end := strings.Index(rest, "\n"+delim)
+	if end == -1 {
+		return templateFrontMatter{}, b, nil
+	}
+
+	fm := rest[:end]
+	body := strings.TrimPrefix(rest[end+1+len(delim):], "\n")
+
+	var parsed templateFrontMatter
+	if err := yaml.Unmarshal([]byte(fm), &parsed); err != nil {
+		return templateFrontMatter{}, nil, err
+	}
+
+	return parsed, []byte(body), nil
+}
+
+// applyTemplateFrontMatter fills in createParams fields left unset by flags,
+// so a template can fully describe an issue on its own.
+func (cc *createCmd) applyTemplateFrontMatter(fm templateFrontMatter) {
+	if cc.params.issueType == "" {
+		cc.params.issueType = fm.Type
+	}
+	if cc.params.summary == "" {
+		cc.params.summary = fm.Summary
+	}
+	if cc.params.priority == "" {
+		cc.params.priority = fm.Priority
+	}
+	if len(cc.params.labels) == 0 {
+		cc.params.labels = fm.Labels
+	}
+	if len(cc.params.components) == 0 {
+		cc.params.components = fm.Components
+	}
+	if cc.params.assignee == "" {
+		cc.params.assignee = fm.Assignee
+	}
+}
+
+// renderTemplateBody runs the template body through text/template with the
+// `.User`, `.Project`, `.Date`, `.Env.FOO` and `.Arg "name"` variables.
+func (cc *createCmd) renderTemplateBody(body []byte, vars map[string]string) (string, error) {
+	tmpl, err := template.New("template").Parse(string(body))
+	if err != nil {
+		return "", err
+	}
+
+	env := make(map[string]string)
+	for _, kv := range os.Environ() {
+		parts := strings.SplitN(kv, "=", 2)
+		if len(parts) == 2 {
+			env[parts[0]] = parts[1]
+		}
+	}
+
+	username := ""
+	if u, err := user.Current(); err == nil {
+		username = u.Username
+	}
+
+	data := templateData{
+		User:    username,
+		Project: cc.project,
+		Date:    time.Now().Format("2006-01-02"),
+		Env:     env,
+		vars:    vars,
+	}
+
+	var out strings.Builder
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", err
+	}
+
+	return out.String(), nil
+}
@@ -0,0 +1,77 @@
+package create
+
+import (
+	"testing"
+)
+
+func TestSplitTemplateFrontMatter(t *testing.T) {
+	t.Run("no front-matter", func(t *testing.T) {
+		body := []byte("Just a plain body.\n")
+
+		fm, rest, err := splitTemplateFrontMatter(body)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if fm.Type != "" || fm.Summary != "" {
+			t.Fatalf("expected empty front-matter, got %+v", fm)
+		}
+		if string(rest) != string(body) {
+			t.Fatalf("expected body unchanged, got %q", rest)
+		}
+	})
+
+	t.Run("with front-matter", func(t *testing.T) {
+		raw := []byte("---\ntype: Bug\nsummary: Something broke\nlabels:\n  - urgent\n---\nBody text here.\n")
+
+		fm, rest, err := splitTemplateFrontMatter(raw)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if fm.Type != "Bug" || fm.Summary != "Something broke" {
+			t.Fatalf("got %+v", fm)
+		}
+		if len(fm.Labels) != 1 || fm.Labels[0] != "urgent" {
+			t.Fatalf("got labels %v", fm.Labels)
+		}
+		if string(rest) != "Body text here.\n" {
+			t.Fatalf("got body %q", rest)
+		}
+	})
+
+	t.Run("unterminated front-matter falls back to plain body", func(t *testing.T) {
+		raw := []byte("---\ntype: Bug\nBody without a closing delimiter")
+
+		fm, rest, err := splitTemplateFrontMatter(raw)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		if fm.Type != "" {
+			t.Fatalf("expected empty front-matter, got %+v", fm)
+		}
+		if string(rest) != string(raw) {
+			t.Fatalf("expected body unchanged, got %q", rest)
+		}
+	})
+
+	t.Run("malformed yaml front-matter errors", func(t *testing.T) {
+		raw := []byte("---\ntype: [unterminated\n---\nBody.\n")
+
+		if _, _, err := splitTemplateFrontMatter(raw); err == nil {
+			t.Fatalf("expected an error for malformed front-matter yaml")
+		}
+	})
+}
+
+func TestParseVars(t *testing.T) {
+	vars, err := parseVars([]string{"env=prod", "owner=jdoe"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if vars["env"] != "prod" || vars["owner"] != "jdoe" {
+		t.Fatalf("got %+v", vars)
+	}
+
+	if _, err := parseVars([]string{"noequals"}); err == nil {
+		t.Fatalf("expected an error for a --var value without '='")
+	}
+}
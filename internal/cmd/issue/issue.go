@@ -0,0 +1,24 @@
+package issue
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/create"
+	"github.com/ankitpokhrel/jira-cli/internal/cmd/issue/vote"
+)
+
+// NewCmdIssue is an issue command.
+func NewCmdIssue() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "issue",
+		Short: "Manage issues",
+	}
+
+	createCmd := create.NewCmdCreate()
+	create.SetFlags(createCmd)
+
+	cmd.AddCommand(createCmd)
+	cmd.AddCommand(vote.NewCmdVote())
+
+	return cmd
+}
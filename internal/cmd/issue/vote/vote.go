@@ -0,0 +1,82 @@
+package vote
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/ankitpokhrel/jira-cli/api"
+	"github.com/ankitpokhrel/jira-cli/internal/cmdutil"
+	"github.com/ankitpokhrel/jira-cli/pkg/jira"
+)
+
+const (
+	helpText = `Vote adds or removes your vote on an issue.`
+	examples = `$ jira issue vote ISSUE-1
+
+# Remove an existing vote
+$ jira issue vote ISSUE-1 --remove`
+)
+
+// NewCmdVote is a vote command.
+func NewCmdVote() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "vote ISSUE-KEY",
+		Short:   "Vote for an issue",
+		Long:    helpText,
+		Example: examples,
+		Args:    cobra.ExactArgs(1),
+		Run:     vote,
+	}
+
+	cmd.Flags().Bool("remove", false, "Remove your vote instead of adding one")
+	cmd.Flags().Bool("down", false, "Alias for --remove")
+
+	return cmd
+}
+
+func vote(cmd *cobra.Command, args []string) {
+	key := args[0]
+
+	remove, err := cmd.Flags().GetBool("remove")
+	cmdutil.ExitIfError(err)
+	if !remove {
+		down, err := cmd.Flags().GetBool("down")
+		cmdutil.ExitIfError(err)
+		remove = down
+	}
+
+	debug, err := cmd.Flags().GetBool("debug")
+	cmdutil.ExitIfError(err)
+
+	client := api.Client(jira.Config{Debug: debug})
+
+	action := "Casting a vote"
+	if remove {
+		action = "Removing your vote"
+	}
+
+	count := func() int {
+		s := cmdutil.Info(fmt.Sprintf("%s for %s...", action, key))
+		defer s.Stop()
+
+		var err error
+		if remove {
+			err = client.RemoveVote(key)
+		} else {
+			err = client.AddVote(key)
+		}
+		cmdutil.ExitIfError(err)
+
+		count, err := client.GetVotes(key)
+		cmdutil.ExitIfError(err)
+
+		return count
+	}()
+
+	if remove {
+		fmt.Printf("\033[0;32m✓\033[0m Vote removed from %s (%d vote(s))\n", key, count)
+	} else {
+		fmt.Printf("\033[0;32m✓\033[0m Voted for %s (%d vote(s))\n", key, count)
+	}
+}
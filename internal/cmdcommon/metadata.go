@@ -0,0 +1,51 @@
+package cmdcommon
+
+import "github.com/AlecAivazis/survey/v2"
+
+// MetadataLinks is the `GetMetadata` picker option that prompts for issue
+// links, handled separately from the other metadata fields since it takes a
+// repeatable type+key pair rather than a single answer.
+const MetadataLinks = "Links"
+
+// GetMetadata returns the metadata picker question asked after the
+// ActionMetadata next action is selected.
+func GetMetadata() []*survey.Question {
+	return []*survey.Question{
+		{
+			Name: "metadata",
+			Prompt: &survey.MultiSelect{
+				Message: "What would you like to add?",
+				Options: []string{"Priority", "Labels", "Components", MetadataLinks},
+			},
+		},
+	}
+}
+
+// GetMetadataQuestions returns the follow-up questions for the metadata
+// fields selected in GetMetadata. MetadataLinks is excluded since it is
+// handled through its own interactive prompt rather than a survey question.
+func GetMetadataQuestions(metadata []string) []*survey.Question {
+	var qs []*survey.Question
+
+	for _, m := range metadata {
+		switch m {
+		case "Priority":
+			qs = append(qs, &survey.Question{
+				Name:   "priority",
+				Prompt: &survey.Input{Message: "Priority"},
+			})
+		case "Labels":
+			qs = append(qs, &survey.Question{
+				Name:   "labels",
+				Prompt: &survey.Input{Message: "Labels"},
+			})
+		case "Components":
+			qs = append(qs, &survey.Question{
+				Name:   "components",
+				Prompt: &survey.Input{Message: "Components"},
+			})
+		}
+	}
+
+	return qs
+}
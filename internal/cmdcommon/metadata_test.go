@@ -0,0 +1,43 @@
+package cmdcommon
+
+import (
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func TestGetMetadataIncludesLinks(t *testing.T) {
+	qs := GetMetadata()
+	if len(qs) != 1 {
+		t.Fatalf("expected exactly one question, got %d", len(qs))
+	}
+
+	prompt, ok := qs[0].Prompt.(*survey.MultiSelect)
+	if !ok {
+		t.Fatalf("expected prompt to be a *survey.MultiSelect, got %T", qs[0].Prompt)
+	}
+
+	var found bool
+	for _, o := range prompt.Options {
+		if o == MetadataLinks {
+			found = true
+			break
+		}
+	}
+	if !found {
+		t.Fatalf("expected %q to be a selectable metadata option, got %v", MetadataLinks, prompt.Options)
+	}
+}
+
+func TestGetMetadataQuestionsExcludesLinks(t *testing.T) {
+	qs := GetMetadataQuestions([]string{"Priority", MetadataLinks})
+
+	for _, q := range qs {
+		if q.Name == "links" {
+			t.Fatalf("MetadataLinks should not produce its own survey question")
+		}
+	}
+	if len(qs) != 1 {
+		t.Fatalf("expected only the Priority question, got %d", len(qs))
+	}
+}
@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiVersion2 = "/rest/api/2"
+
+// Config is the configuration required to construct a Client.
+type Config struct {
+	Server   string
+	Login    string
+	APIToken string
+	Insecure bool
+	Debug    bool
+}
+
+// Client is a Jira REST API client.
+type Client struct {
+	server     string
+	login      string
+	apiToken   string
+	debug      bool
+	httpClient *http.Client
+}
+
+// NewClient constructs a Client from the given Config.
+func NewClient(c Config) *Client {
+	return &Client{
+		server:   c.Server,
+		login:    c.Login,
+		apiToken: c.APIToken,
+		debug:    c.Debug,
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+}
+
+// request issues an authenticated request against the Jira REST API v2 and
+// returns the raw response for the caller to decode. The caller is
+// responsible for closing the response body.
+func (c *Client) request(method, path string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.server+apiVersion2+path, body)
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.login, c.apiToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode >= http.StatusBadRequest {
+		defer func() { _ = res.Body.Close() }()
+		return nil, fmt.Errorf("unexpected response code %d from %s %s", res.StatusCode, method, path)
+	}
+
+	return res, nil
+}
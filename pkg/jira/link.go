@@ -0,0 +1,65 @@
+package jira
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+)
+
+// IssueLinkType is a type of link between two issues, eg. "Blocks", "Relates".
+type IssueLinkType struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetIssueLinkTypes fetches the issue link types configured on the Jira
+// instance, used to validate `--link` flag values before creating an issue.
+func (c *Client) GetIssueLinkTypes() ([]*IssueLinkType, error) {
+	res, err := c.request(http.MethodGet, "/issueLinkType", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	var out struct {
+		IssueLinkTypes []*IssueLinkType `json:"issueLinkTypes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return nil, err
+	}
+
+	return out.IssueLinkTypes, nil
+}
+
+type linkIssueRequest struct {
+	Type struct {
+		Name string `json:"name"`
+	} `json:"type"`
+	InwardIssue struct {
+		Key string `json:"key"`
+	} `json:"inwardIssue"`
+	OutwardIssue struct {
+		Key string `json:"key"`
+	} `json:"outwardIssue"`
+}
+
+// LinkIssue links inwardKey to outwardKey using the given link type name.
+func (c *Client) LinkIssue(inwardKey, outwardKey, linkType string) error {
+	var body linkIssueRequest
+	body.Type.Name = linkType
+	body.InwardIssue.Key = inwardKey
+	body.OutwardIssue.Key = outwardKey
+
+	b, err := json.Marshal(&body)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.request(http.MethodPost, "/issueLink", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return nil
+}
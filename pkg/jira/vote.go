@@ -0,0 +1,47 @@
+package jira
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AddVote casts the current user's vote for the given issue.
+func (c *Client) AddVote(key string) error {
+	res, err := c.request(http.MethodPost, fmt.Sprintf("/issue/%s/votes", key), nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return nil
+}
+
+// RemoveVote removes the current user's vote from the given issue.
+func (c *Client) RemoveVote(key string) error {
+	res, err := c.request(http.MethodDelete, fmt.Sprintf("/issue/%s/votes", key), nil)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	return nil
+}
+
+// GetVotes returns the current vote count for the given issue.
+func (c *Client) GetVotes(key string) (int, error) {
+	res, err := c.request(http.MethodGet, fmt.Sprintf("/issue/%s/votes", key), nil)
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = res.Body.Close() }()
+
+	var out struct {
+		Votes int `json:"votes"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&out); err != nil {
+		return 0, err
+	}
+
+	return out.Votes, nil
+}
@@ -0,0 +1,44 @@
+package jira
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestVotes(t *testing.T) {
+	var gotMethod, gotPath string
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod = r.Method
+		gotPath = r.URL.Path
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"votes": 3}`))
+	}))
+	defer ts.Close()
+
+	c := NewClient(Config{Server: ts.URL})
+
+	if err := c.AddVote("TEST-1"); err != nil {
+		t.Fatalf("AddVote: unexpected error: %s", err)
+	}
+	if gotMethod != http.MethodPost || gotPath != "/rest/api/2/issue/TEST-1/votes" {
+		t.Fatalf("AddVote: got %s %s", gotMethod, gotPath)
+	}
+
+	if err := c.RemoveVote("TEST-1"); err != nil {
+		t.Fatalf("RemoveVote: unexpected error: %s", err)
+	}
+	if gotMethod != http.MethodDelete {
+		t.Fatalf("RemoveVote: got method %s", gotMethod)
+	}
+
+	votes, err := c.GetVotes("TEST-1")
+	if err != nil {
+		t.Fatalf("GetVotes: unexpected error: %s", err)
+	}
+	if votes != 3 {
+		t.Fatalf("GetVotes: got %d, want 3", votes)
+	}
+}
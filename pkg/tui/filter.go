@@ -0,0 +1,85 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// matchesQuery reports whether s contains query as a case-insensitive
+// substring, the shared matching rule behind both filterPreviewData and
+// filterTableData.
+func matchesQuery(s, query string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(query))
+}
+
+// filterBar is a footer-overlaying input line that lets a grid-based layout
+// offer incremental filtering without owning its own input wiring. Pressing
+// `/` opens it in place of the footer; Enter commits the query, Esc restores
+// the previous view. It's deliberately generic over the footer view and grid
+// cell it occupies so any `tview.Grid`-based layout can reuse it, the way
+// both Preview's sidebar and Table do.
+type filterBar struct {
+	grid       *tview.Grid
+	footerView *tview.TextView
+	input      *tview.InputField
+
+	row, col, rowSpan, colSpan int
+
+	lastQuery string
+}
+
+// newFilterBar returns a filterBar that overlays the footer occupying the
+// given grid cell.
+func newFilterBar(grid *tview.Grid, footerView *tview.TextView, row, col, rowSpan, colSpan int) *filterBar {
+	input := tview.NewInputField().
+		SetLabel("/").
+		SetFieldBackgroundColor(tcell.ColorBlack)
+
+	return &filterBar{
+		grid:       grid,
+		footerView: footerView,
+		input:      input,
+		row:        row,
+		col:        col,
+		rowSpan:    rowSpan,
+		colSpan:    colSpan,
+	}
+}
+
+// open replaces the footer with the input line. onChange fires on every
+// keystroke, onCommit when Enter is pressed and onCancel when Esc is pressed.
+func (f *filterBar) open(onChange func(query string), onCommit func(query string), onCancel func()) {
+	// Detach whatever handler a previous open() left behind before clearing
+	// the text, otherwise SetText("") below fires the stale onChange with an
+	// empty query and the view flashes back to the full list for a moment.
+	f.input.SetChangedFunc(nil)
+	f.input.SetText("")
+	f.input.SetChangedFunc(onChange)
+	f.input.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			f.lastQuery = f.input.GetText()
+			f.close()
+			onCommit(f.lastQuery)
+
+		case tcell.KeyEsc:
+			f.close()
+			onCancel()
+		}
+	})
+
+	f.grid.RemoveItem(f.footerView)
+	f.grid.AddItem(f.input, f.row, f.col, f.rowSpan, f.colSpan, 0, 0, true)
+}
+
+// query returns the last committed filter query.
+func (f *filterBar) query() string {
+	return f.lastQuery
+}
+
+func (f *filterBar) close() {
+	f.grid.RemoveItem(f.input)
+	f.grid.AddItem(f.footerView, f.row, f.col, f.rowSpan, f.colSpan, 0, 0, false)
+}
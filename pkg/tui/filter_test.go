@@ -0,0 +1,102 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func TestFilterPreviewData(t *testing.T) {
+	data := []PreviewData{
+		{Menu: "Header"},
+		{Menu: "Bug: login broken"},
+		{Menu: "Task: update docs"},
+		{Menu: "Bug: checkout crash"},
+	}
+
+	t.Run("empty query returns everything", func(t *testing.T) {
+		got := filterPreviewData(data, "")
+		if len(got) != len(data) {
+			t.Fatalf("got %d rows, want %d", len(got), len(data))
+		}
+	})
+
+	t.Run("filters by substring, keeps header", func(t *testing.T) {
+		got := filterPreviewData(data, "bug")
+		if len(got) != 3 {
+			t.Fatalf("got %d rows, want 3 (header + 2 matches)", len(got))
+		}
+		if got[0].Menu != "Header" {
+			t.Fatalf("expected header row to stay first, got %q", got[0].Menu)
+		}
+	})
+
+	t.Run("case insensitive", func(t *testing.T) {
+		got := filterPreviewData(data, "LOGIN")
+		if len(got) != 2 {
+			t.Fatalf("got %d rows, want 2 (header + 1 match)", len(got))
+		}
+	})
+
+	t.Run("no matches still keeps header", func(t *testing.T) {
+		got := filterPreviewData(data, "nonexistent")
+		if len(got) != 1 || got[0].Menu != "Header" {
+			t.Fatalf("got %+v, want only the header row", got)
+		}
+	})
+}
+
+// TestOpenFilterCancelRestoresLastCommitted exercises the open/commit/cancel
+// integration, not just the pure filterPreviewData helper: committing a
+// filter and then cancelling a second, untyped filter attempt must leave the
+// previously-committed filtered view in place rather than resetting to the
+// full list.
+func TestOpenFilterCancelRestoresLastCommitted(t *testing.T) {
+	// renderSidebar always re-selects row 0, which fires the selection's
+	// contents goroutine; wait on it after every action that re-renders the
+	// sidebar so the test doesn't race that goroutine's writes.
+	rendered := make(chan struct{}, 1)
+	contentsFn := func(string) interface{} {
+		rendered <- struct{}{}
+		return ""
+	}
+
+	pv := NewPreview()
+
+	pv.allData = []PreviewData{
+		{Menu: "Header", Contents: contentsFn},
+		{Menu: "Bug: login broken"},
+		{Menu: "Task: update docs"},
+		{Menu: "Bug: checkout crash"},
+	}
+	pv.renderSidebar(pv.allData)
+	<-rendered
+
+	pressKey := func(key tcell.Key, r rune) {
+		pv.filter.input.InputHandler()(tcell.NewEventKey(key, r, tcell.ModNone), func(tview.Primitive) {})
+		<-rendered
+	}
+
+	// Open the filter, type "bug" and commit it with Enter.
+	pv.openFilter(pv.sidebar)
+	for _, r := range "bug" {
+		pressKey(tcell.KeyRune, r)
+	}
+	pressKey(tcell.KeyEnter, 0)
+
+	if len(pv.visibleData) != 3 {
+		t.Fatalf("after commit: got %d visible rows, want 3", len(pv.visibleData))
+	}
+
+	// Reopen the filter and cancel without typing anything.
+	pv.openFilter(pv.sidebar)
+	pressKey(tcell.KeyEsc, 0)
+
+	if len(pv.visibleData) != 3 {
+		t.Fatalf("after cancel: got %d visible rows, want 3 (last committed filter), got %+v", len(pv.visibleData), pv.visibleData)
+	}
+	if pv.visibleData[1].Menu != "Bug: login broken" {
+		t.Fatalf("after cancel: got %+v, want the last committed filter's rows", pv.visibleData)
+	}
+}
@@ -1,6 +1,8 @@
 package tui
 
 import (
+	"fmt"
+
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
 )
@@ -11,6 +13,7 @@ const sidebarMaxWidth = 60
 type PreviewData struct {
 	Key      string
 	Menu     string
+	Votes    *int
 	Contents func(string) interface{}
 }
 
@@ -22,6 +25,13 @@ type Preview struct {
 	contents    *Table
 	initialText string
 	footerText  string
+
+	footerView *tview.TextView
+
+	allData     []PreviewData
+	visibleData []PreviewData
+
+	filter *filterBar
 }
 
 // PreviewOption is a functional option to wrap preview properties.
@@ -32,8 +42,12 @@ func NewPreview(opts ...PreviewOption) *Preview {
 	tview.Styles.PrimitiveBackgroundColor = tcell.ColorBlack
 
 	pv := Preview{
-		screen:   NewScreen(),
-		contents: NewTable(),
+		screen: NewScreen(),
+		// Preview only ever reads pv.contents.view, rendering into Preview's
+		// own grid/screen/filter rather than Table's standalone ones, so it's
+		// built directly instead of via NewTable (which would wire up a
+		// whole separate, unused Screen/Grid/filterBar of its own).
+		contents: &Table{},
 	}
 
 	for _, opt := range opts {
@@ -63,6 +77,9 @@ func NewPreview(opts ...PreviewOption) *Preview {
 
 	pv.sidebar = sidebar
 	pv.contents.view = contents
+	pv.footerView = footerView
+
+	pv.filter = newFilterBar(pv.painter, footerView, 2, 0, 1, 3)
 
 	pv.initLayout(sidebar, contents)
 	pv.initLayout(contents, sidebar)
@@ -91,6 +108,18 @@ func (pv *Preview) initLayout(view *tview.Table, nextView *tview.Table) {
 				} else {
 					pv.screen.SetFocus(view)
 				}
+
+			case '/':
+				pv.openFilter(view)
+				return nil
+
+			case 'n':
+				pv.jumpToMatch(false)
+				return nil
+
+			case 'N':
+				pv.jumpToMatch(true)
+				return nil
 			}
 		}
 
@@ -120,6 +149,22 @@ func (pv *Preview) Render(pd []PreviewData) error {
 		return errNoData
 	}
 
+	pv.allData = pd
+
+	pv.renderSidebar(pd)
+
+	pv.printText(pv.initialText)
+
+	return pv.screen.Paint(pv.painter)
+}
+
+// renderSidebar repopulates the sidebar table with the given subset of rows
+// and wires up selection handling against that subset.
+func (pv *Preview) renderSidebar(pd []PreviewData) {
+	pv.visibleData = pd
+
+	pv.sidebar.Clear()
+
 	for i, d := range pd {
 		style := tcell.StyleDefault
 		if i == 0 {
@@ -131,18 +176,83 @@ func (pv *Preview) Render(pd []PreviewData) error {
 			SetStyle(style)
 
 		pv.sidebar.SetCell(i, 0, cell)
+	}
 
-		pv.sidebar.SetSelectionChangedFunc(func(r, c int) {
-			pv.contents.view.Clear()
-			pv.printText("Loading...")
+	pv.sidebar.SetSelectionChangedFunc(func(r, c int) {
+		if r < 0 || r >= len(pv.visibleData) {
+			return
+		}
 
-			go pv.renderContents(pd[r])
-		})
+		pv.contents.view.Clear()
+		pv.printText("Loading...")
+
+		go pv.renderContents(pv.visibleData[r])
+	})
+
+	pv.sidebar.Select(0, 0)
+}
+
+// openFilter opens the filter input line at the bottom of the screen and
+// narrows the sidebar to entries whose Menu field matches as the user types.
+func (pv *Preview) openFilter(focused *tview.Table) {
+	if focused != pv.sidebar {
+		pv.screen.SetFocus(pv.sidebar)
 	}
 
-	pv.printText(pv.initialText)
+	pv.filter.open(
+		func(query string) { // onChange
+			pv.renderSidebar(filterPreviewData(pv.allData, query))
+		},
+		func(query string) { // onCommit
+			pv.renderSidebar(filterPreviewData(pv.allData, query))
+			pv.screen.SetFocus(pv.sidebar)
+		},
+		func() { // onCancel
+			pv.renderSidebar(filterPreviewData(pv.allData, pv.filter.query()))
+			pv.screen.SetFocus(pv.sidebar)
+		},
+	)
+
+	pv.screen.SetFocus(pv.filter.input)
+}
 
-	return pv.screen.Paint(pv.painter)
+// jumpToMatch moves the sidebar selection to the previous (reverse) or next
+// match of the last committed filter query, wrapping around the matched rows
+// (row 0, the header kept by filterPreviewData, is skipped).
+func (pv *Preview) jumpToMatch(reverse bool) {
+	if pv.filter.query() == "" || len(pv.visibleData) <= 1 {
+		return
+	}
+
+	row, _ := pv.sidebar.GetSelection()
+	if reverse {
+		row--
+		if row < 1 {
+			row = len(pv.visibleData) - 1
+		}
+	} else {
+		row++
+		if row >= len(pv.visibleData) {
+			row = 1
+		}
+	}
+
+	pv.sidebar.Select(row, 0)
+}
+
+func filterPreviewData(pd []PreviewData, query string) []PreviewData {
+	if query == "" {
+		return pd
+	}
+
+	out := make([]PreviewData, 0, len(pd))
+	for i, d := range pd {
+		if i == 0 || matchesQuery(d.Menu, query) {
+			out = append(out, d)
+		}
+	}
+
+	return out
 }
 
 func (pv *Preview) renderContents(pd PreviewData) {
@@ -154,7 +264,7 @@ func (pv *Preview) renderContents(pd PreviewData) {
 
 	switch v := pd.Contents(pd.Key).(type) {
 	case string:
-		pv.printText(v)
+		pv.printText(withVotesRow(v, pd.Votes))
 
 	case TableData:
 		pv.screen.QueueUpdateDraw(func() {
@@ -174,6 +284,16 @@ func (pv *Preview) renderContents(pd PreviewData) {
 	}
 }
 
+// withVotesRow prepends a "Votes" row next to the rest of an issue's
+// metadata when the caller has supplied a vote count to show.
+func withVotesRow(text string, votes *int) string {
+	if votes == nil {
+		return text
+	}
+
+	return fmt.Sprintf("Votes: %d\n%s", *votes, text)
+}
+
 func (pv *Preview) printText(s string) {
 	lines := splitText(s)
 
@@ -182,4 +302,4 @@ func (pv *Preview) printText(s string) {
 			SetStyle(tcell.StyleDefault).
 			SetSelectable(false))
 	}
-}
\ No newline at end of file
+}
@@ -0,0 +1,25 @@
+package tui
+
+import "testing"
+
+func TestWithVotesRow(t *testing.T) {
+	votes := 4
+
+	cases := []struct {
+		name  string
+		text  string
+		votes *int
+		want  string
+	}{
+		{name: "no votes supplied", text: "Summary: foo", votes: nil, want: "Summary: foo"},
+		{name: "votes supplied", text: "Summary: foo", votes: &votes, want: "Votes: 4\nSummary: foo"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := withVotesRow(tc.text, tc.votes); got != tc.want {
+				t.Fatalf("got %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package tui
+
+import "github.com/rivo/tview"
+
+// Screen wraps a tview.Application so every layout (Preview, Table) shares
+// the same event loop and a uniform way to paint itself, move focus and stop.
+type Screen struct {
+	app *tview.Application
+}
+
+// NewScreen returns a new screen backed by a fresh tview application.
+func NewScreen() *Screen {
+	return &Screen{app: tview.NewApplication()}
+}
+
+// Paint sets root as the screen's root primitive and runs the event loop
+// until the screen is stopped.
+func (s *Screen) Paint(root tview.Primitive) error {
+	return s.app.SetRoot(root, true).EnableMouse(false).Run()
+}
+
+// SetFocus moves input focus to the given primitive.
+func (s *Screen) SetFocus(p tview.Primitive) *tview.Application {
+	return s.app.SetFocus(p)
+}
+
+// QueueUpdateDraw queues f to run on the event loop and redraws the screen
+// once it's done.
+func (s *Screen) QueueUpdateDraw(f func()) *tview.Application {
+	return s.app.QueueUpdateDraw(f)
+}
+
+// Stop stops the event loop.
+func (s *Screen) Stop() {
+	s.app.Stop()
+}
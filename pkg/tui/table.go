@@ -0,0 +1,229 @@
+package tui
+
+import (
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// TableData is the data rendered by Table. The first row is treated as the
+// header row.
+type TableData [][]string
+
+// Table is a standalone, scrollable table layout with its own screen, grid
+// and filterBar. Preview also borrows its `view` field directly for its
+// contents pane (see Preview.contents).
+type Table struct {
+	screen  *Screen
+	painter *tview.Grid
+	view    *tview.Table
+
+	footerView *tview.TextView
+	footerText string
+
+	allData     TableData
+	visibleData TableData
+
+	filter *filterBar
+}
+
+// TableOption is a functional option to wrap table properties.
+type TableOption func(t *Table)
+
+// NewTable returns a new table layout.
+func NewTable(opts ...TableOption) *Table {
+	tview.Styles.PrimitiveBackgroundColor = tcell.ColorBlack
+
+	t := Table{
+		screen: NewScreen(),
+		view:   tview.NewTable(),
+	}
+
+	for _, opt := range opts {
+		opt(&t)
+	}
+
+	footerView := tview.NewTextView().SetWordWrap(true)
+	initFooterView(footerView, t.footerText)
+
+	t.painter = tview.NewGrid().
+		SetRows(0, 2).
+		SetColumns(0).
+		AddItem(t.view, 0, 0, 1, 1, 0, 0, true).
+		AddItem(footerView, 1, 0, 1, 1, 0, 0, false)
+
+	t.painter.SetBackgroundColor(tcell.ColorBlack)
+	t.footerView = footerView
+	t.filter = newFilterBar(t.painter, footerView, 1, 0, 1, 1)
+
+	t.initLayout()
+
+	return &t
+}
+
+// WithTableFooterText sets footer text that is displayed after the table layout.
+func WithTableFooterText(text string) TableOption {
+	return func(t *Table) {
+		t.footerText = text
+	}
+}
+
+func (t *Table) initLayout() {
+	t.view.SetSelectable(true, false)
+	t.view.SetFixed(1, 1)
+
+	t.view.SetDoneFunc(func(key tcell.Key) {
+		if key == tcell.KeyEsc {
+			t.screen.Stop()
+		}
+	})
+
+	t.view.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
+		if event.Key() == tcell.KeyRune {
+			switch event.Rune() {
+			case 'q':
+				t.screen.Stop()
+
+			case '/':
+				t.openFilter()
+				return nil
+
+			case 'n':
+				t.jumpToMatch(false)
+				return nil
+
+			case 'N':
+				t.jumpToMatch(true)
+				return nil
+			}
+		}
+
+		return event
+	})
+}
+
+// Render renders the table layout. The first row is treated as a header.
+func (t *Table) Render(data TableData) error {
+	if len(data) == 0 {
+		return errNoData
+	}
+
+	t.allData = data
+	t.renderRows(data)
+
+	return t.screen.Paint(t.painter)
+}
+
+// renderRows repopulates the table with the given subset of rows, keeping
+// the header pinned at row 0.
+func (t *Table) renderRows(data TableData) {
+	t.visibleData = data
+
+	t.view.Clear()
+
+	if len(data) == 0 {
+		return
+	}
+
+	renderTableHeader(t, data[0])
+
+	if len(data) == 1 {
+		t.view.SetCell(1, 0, tview.NewTableCell(pad("No results to show.", 1)).
+			SetSelectable(false).
+			SetStyle(tcell.StyleDefault))
+
+		return
+	}
+
+	renderTableCell(t, data)
+
+	if len(data) > 1 {
+		t.view.Select(1, 0)
+	}
+}
+
+// openFilter opens the filter input line at the bottom of the screen and
+// narrows the table to rows matching as the user types, mirroring
+// Preview.openFilter in preview.go.
+func (t *Table) openFilter() {
+	t.filter.open(
+		func(query string) { // onChange
+			t.renderRows(filterTableData(t.allData, query))
+		},
+		func(query string) { // onCommit
+			t.renderRows(filterTableData(t.allData, query))
+			t.screen.SetFocus(t.view)
+		},
+		func() { // onCancel
+			t.renderRows(filterTableData(t.allData, t.filter.query()))
+			t.screen.SetFocus(t.view)
+		},
+	)
+
+	t.screen.SetFocus(t.filter.input)
+}
+
+// jumpToMatch moves the selection to the previous (reverse) or next row of
+// the last committed filter query, wrapping around the data rows (row 0,
+// the header, is never selected).
+func (t *Table) jumpToMatch(reverse bool) {
+	if t.filter.query() == "" || len(t.visibleData) <= 1 {
+		return
+	}
+
+	row, _ := t.view.GetSelection()
+	if reverse {
+		row--
+		if row < 1 {
+			row = len(t.visibleData) - 1
+		}
+	} else {
+		row++
+		if row >= len(t.visibleData) {
+			row = 1
+		}
+	}
+
+	t.view.Select(row, 0)
+}
+
+// filterTableData narrows data to the header row plus rows with at least one
+// cell matching query as a case-insensitive substring.
+func filterTableData(data TableData, query string) TableData {
+	if query == "" || len(data) == 0 {
+		return data
+	}
+
+	out := make(TableData, 0, len(data))
+	out = append(out, data[0])
+
+	for _, row := range data[1:] {
+		for _, cell := range row {
+			if matchesQuery(cell, query) {
+				out = append(out, row)
+				break
+			}
+		}
+	}
+
+	return out
+}
+
+func renderTableHeader(t *Table, header []string) {
+	for c, h := range header {
+		t.view.SetCell(0, c, tview.NewTableCell(pad(h, 1)).
+			SetSelectable(false).
+			SetStyle(tcell.StyleDefault.Bold(true)))
+	}
+}
+
+func renderTableCell(t *Table, data TableData) {
+	for r, row := range data {
+		if r == 0 {
+			continue
+		}
+
+		for c, v := range row {
+			t.view.SetCell(r, c, tview.NewTableCell(pad(v, 1)).SetStyle(tcell.StyleDefault))
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package tui
+
+import (
+	"testing"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+func TestFilterTableData(t *testing.T) {
+	data := TableData{
+		{"KEY", "SUMMARY"},
+		{"PRJ-1", "login broken"},
+		{"PRJ-2", "update docs"},
+		{"PRJ-3", "checkout crash"},
+	}
+
+	t.Run("empty query returns everything", func(t *testing.T) {
+		got := filterTableData(data, "")
+		if len(got) != len(data) {
+			t.Fatalf("got %d rows, want %d", len(got), len(data))
+		}
+	})
+
+	t.Run("filters by substring in any column, keeps header", func(t *testing.T) {
+		got := filterTableData(data, "prj-1")
+		if len(got) != 2 {
+			t.Fatalf("got %d rows, want 2 (header + 1 match)", len(got))
+		}
+		if got[0][0] != "KEY" {
+			t.Fatalf("expected header row to stay first, got %v", got[0])
+		}
+	})
+
+	t.Run("case insensitive", func(t *testing.T) {
+		got := filterTableData(data, "CRASH")
+		if len(got) != 2 {
+			t.Fatalf("got %d rows, want 2 (header + 1 match)", len(got))
+		}
+	})
+
+	t.Run("no matches still keeps header", func(t *testing.T) {
+		got := filterTableData(data, "nonexistent")
+		if len(got) != 1 || got[0][0] != "KEY" {
+			t.Fatalf("got %v, want only the header row", got)
+		}
+	})
+}
+
+func TestTableRenderRowsNoResults(t *testing.T) {
+	tbl := NewTable()
+
+	tbl.renderRows(TableData{{"KEY", "SUMMARY"}})
+
+	cell := tbl.view.GetCell(1, 0)
+	if cell == nil || cell.Text == "" {
+		t.Fatalf("expected a placeholder row when only the header is rendered, got %+v", cell)
+	}
+}
+
+// TestTableOpenFilterCancelRestoresLastCommitted mirrors
+// TestOpenFilterCancelRestoresLastCommitted in filter_test.go: committing a
+// filter and then cancelling a second, untyped filter attempt must leave the
+// previously-committed filtered view in place, not reset to the full table.
+func TestTableOpenFilterCancelRestoresLastCommitted(t *testing.T) {
+	tbl := NewTable()
+
+	tbl.allData = TableData{
+		{"KEY", "SUMMARY"},
+		{"PRJ-1", "login broken"},
+		{"PRJ-2", "update docs"},
+		{"PRJ-3", "checkout crash"},
+	}
+	tbl.renderRows(tbl.allData)
+
+	pressKey := func(key tcell.Key, r rune) {
+		tbl.filter.input.InputHandler()(tcell.NewEventKey(key, r, tcell.ModNone), func(tview.Primitive) {})
+	}
+
+	tbl.openFilter()
+	for _, r := range "prj-1" {
+		pressKey(tcell.KeyRune, r)
+	}
+	pressKey(tcell.KeyEnter, 0)
+
+	if len(tbl.visibleData) != 2 {
+		t.Fatalf("after commit: got %d visible rows, want 2", len(tbl.visibleData))
+	}
+
+	tbl.openFilter()
+	pressKey(tcell.KeyEsc, 0)
+
+	if len(tbl.visibleData) != 2 {
+		t.Fatalf("after cancel: got %d visible rows, want 2 (last committed filter), got %+v", len(tbl.visibleData), tbl.visibleData)
+	}
+	if tbl.visibleData[1][0] != "PRJ-1" {
+		t.Fatalf("after cancel: got %+v, want the last committed filter's rows", tbl.visibleData)
+	}
+}
@@ -0,0 +1,35 @@
+package tui
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/rivo/tview"
+)
+
+// errNoData is returned by Render when there's nothing to display.
+var errNoData = errors.New("no data to display")
+
+const defaultFooterText = "Press 'q' to quit, '/' to filter, 'n'/'N' to jump between matches"
+
+// pad pads s with n spaces on either side.
+func pad(s string, n int) string {
+	p := strings.Repeat(" ", n)
+	return p + s + p
+}
+
+// splitText splits s on newlines so each line can be rendered as its own
+// table row in a single-column contents view.
+func splitText(s string) []string {
+	return strings.Split(s, "\n")
+}
+
+// initFooterView sets the footer's text, falling back to the default key
+// bindings hint when the caller didn't supply custom footer text.
+func initFooterView(view *tview.TextView, text string) {
+	if text == "" {
+		text = defaultFooterText
+	}
+
+	view.SetText(text)
+}